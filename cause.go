@@ -0,0 +1,44 @@
+package errors
+
+// Cause walks err's chain to its root, preferring Cause() error when a
+// node implements it - that's what lets a *Error (built from Wrap/Wrapf,
+// with its own disambiguation over its [contextErr, wrappedErr] children)
+// resolve to the actual wrapped error instead of bailing out as a
+// multi-child node - and falling back to Unwrap (when err has exactly one
+// child) absent that, so it still reaches the bottom of a chain mixing a
+// legacy pkg/errors-style causer with stdlib-only errors. It stops as soon
+// as it hits a node with neither method, or an Unwrap() []error node with
+// zero or more than one child, since "the cause" isn't well-defined once
+// there's more than one path down and no Cause() to disambiguate it.
+func Cause(err error) error {
+	for {
+		if c, ok := err.(interface{ Cause() error }); ok {
+			next := c.Cause()
+			if next == nil {
+				return err
+			}
+			err = next
+			continue
+		}
+
+		if u, ok := err.(interface{ Unwrap() []error }); ok {
+			children := u.Unwrap()
+			if len(children) != 1 {
+				return err
+			}
+			err = children[0]
+			continue
+		}
+
+		if u, ok := err.(interface{ Unwrap() error }); ok {
+			next := u.Unwrap()
+			if next == nil {
+				return err
+			}
+			err = next
+			continue
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,105 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// legacyCauser mimics the pre-1.13 pkg/errors wrapping convention: it
+// exposes its parent only through Cause(), not Unwrap().
+type legacyCauser struct {
+	msg   string
+	cause error
+}
+
+func (c *legacyCauser) Error() string { return c.msg }
+func (c *legacyCauser) Cause() error  { return c.cause }
+
+func TestCauseBridge(t *testing.T) {
+	t.Run("this module's Wrap reaches a root wrapped by a legacy causer", func(t *testing.T) {
+		root := errors.New("root")
+		legacy := &legacyCauser{msg: "legacy context", cause: root}
+		outer := Wrap(legacy, "our context")
+
+		assert.True(t, Is(outer, root))
+		assert.True(t, Is(outer, legacy))
+
+		var target *legacyCauser
+		assert.True(t, As(outer, &target))
+		assert.Equal(t, legacy, target)
+	})
+
+	t.Run("a legacy causer reaches a root built with this module's New", func(t *testing.T) {
+		root := New("root")
+		legacy := &legacyCauser{msg: "legacy context", cause: root}
+
+		assert.True(t, Is(legacy, root))
+
+		var target *Error
+		assert.True(t, As(legacy, &target))
+		assert.Equal(t, root, target)
+	})
+
+	t.Run("package Unwrap falls back to Cause", func(t *testing.T) {
+		root := errors.New("root")
+		legacy := &legacyCauser{msg: "legacy", cause: root}
+
+		assert.Equal(t, root, Unwrap(legacy))
+	})
+
+	t.Run("*Error satisfies the Causer interface", func(t *testing.T) {
+		var _ interface{ Cause() error } = (*Error)(nil)
+
+		leaf := New("leaf")
+		assert.Nil(t, leaf.Cause())
+
+		inner := New("inner")
+		outer := Wrap(inner, "outer")
+		assert.Equal(t, inner, outer.Cause())
+	})
+}
+
+func TestCauseFunc(t *testing.T) {
+	t.Run("walks a pure legacy chain to its root", func(t *testing.T) {
+		root := errors.New("root")
+		mid := &legacyCauser{msg: "mid", cause: root}
+		top := &legacyCauser{msg: "top", cause: mid}
+
+		assert.Equal(t, root, Cause(top))
+	})
+
+	t.Run("follows a single-child Unwrap chain", func(t *testing.T) {
+		root := errors.New("root")
+		wrapped := fmt.Errorf("context: %w", root)
+
+		assert.Equal(t, root, Cause(wrapped))
+	})
+
+	t.Run("defers to *Error's own Cause() instead of bailing on its children", func(t *testing.T) {
+		inner := errors.New("inner")
+		outer := Wrap(inner, "outer")
+
+		assert.Equal(t, inner, Cause(outer))
+	})
+
+	t.Run("mixes Unwrap and Cause across the same chain", func(t *testing.T) {
+		root := New("root")
+		legacy := &legacyCauser{msg: "legacy", cause: root}
+		wrapped := fmt.Errorf("context: %w", legacy)
+
+		assert.Equal(t, error(root), Cause(wrapped))
+	})
+
+	t.Run("Cause() and *Error.Cause() agree when Wrap mixes in a legacy causer", func(t *testing.T) {
+		root := errors.New("root")
+		legacy := &legacyCauser{msg: "legacy context", cause: root}
+		outer := Wrap(legacy, "our context")
+
+		assert.Equal(t, legacy, outer.Cause())
+		assert.Equal(t, Cause(outer.Cause()), Cause(outer))
+		assert.Equal(t, root, Cause(outer))
+	})
+}
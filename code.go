@@ -0,0 +1,147 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Code identifies a specific error condition within a Codespace.
+type Code uint32
+
+// Codespace namespaces a Code so the same numeric value can mean different
+// things in different subsystems, the way ABCI/cosmos-sdk errors do.
+type Codespace string
+
+// codeInfo is the (codespace, code) pair attached to an *Error, either by
+// Register or WithCode.
+type codeInfo struct {
+	codespace Codespace
+	code      Code
+}
+
+type registryKey struct {
+	codespace Codespace
+	code      Code
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[registryKey]string{}
+)
+
+// Register declares a new (codespace, code) pair with a human-readable
+// description and returns a sentinel *Error carrying it. Registering the
+// same pair twice panics: duplicate registration is always a programming
+// error, not something to recover from at runtime. Because each call
+// returns a distinct, package-level *Error, the usual
+//
+//	var ErrNotFound = errors.Register("mymodule", 1, "not found")
+//
+// pattern gives you a sentinel that's cheap to compare with Is, the same
+// way a plain errors.New sentinel is.
+func Register(codespace string, code uint32, description string) *Error {
+	key := registryKey{Codespace(codespace), Code(code)}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, exists := registry[key]; exists {
+		panic(fmt.Sprintf("errors: code %d already registered for codespace %q", code, codespace))
+	}
+	registry[key] = description
+
+	return &Error{
+		err:   errors.New(description),
+		trace: callers(1),
+		code:  &codeInfo{codespace: Codespace(codespace), code: Code(code)},
+	}
+}
+
+// WithCode returns a copy of err tagged with c, wrapping it as an *Error
+// first if it isn't one already. Unlike Register, it doesn't touch the
+// codespace registry, so it's the lighter-weight way to classify an
+// existing error without declaring it up front. WithCode(nil, c) returns
+// nil: there's nothing to classify, the same way Unwrap-ing a nil error
+// stays nil instead of producing a non-nil *Error with no message.
+//
+// Re-tagging an err that already carries a code (e.g. a Register
+// sentinel) replaces it wholesale, codespace included - a Code carries no
+// codespace of its own, so the result's codespace is always empty.
+func WithCode(err error, c Code) *Error {
+	if err == nil {
+		return nil
+	}
+
+	e, ok := err.(*Error)
+	if !ok {
+		e = &Error{err: err, trace: callers(1)}
+	}
+
+	cp := *e
+	cp.code = &codeInfo{code: c}
+	return &cp
+}
+
+// CodeOf walks err's tree and returns the codespace and code of the first
+// *Error node it finds carrying one (see Register and WithCode), so a
+// wrapped error can be classified without string matching. ok is false if
+// nothing in the tree was ever given a code.
+func CodeOf(err error) (codespace string, code uint32, ok bool) {
+	var found *codeInfo
+
+	walkErrors(err, func(err error) bool {
+		if e, isErr := err.(*Error); isErr && e.code != nil {
+			found = e.code
+			return true
+		}
+		return false
+	})
+
+	if found == nil {
+		return "", 0, false
+	}
+	return string(found.codespace), uint32(found.code), true
+}
+
+// Info renders a stable classification of err: its codespace, numeric
+// code, and a log string combining the two with its message, mirroring the
+// ABCIInfo helper cosmos-sdk's errors package exposes for responses that
+// need a code's codespace and number alongside a human-readable line.
+func Info(err error) (codespace string, code uint32, log string) {
+	if err == nil {
+		return "", 0, ""
+	}
+
+	codespace, code, ok := CodeOf(err)
+	if !ok {
+		return "", 0, err.Error()
+	}
+	return codespace, code, fmt.Sprintf("codespace: %s, code: %d: %s", codespace, code, err.Error())
+}
+
+var (
+	httpMappingMu sync.Mutex
+	httpMapping   = map[uint32]int{}
+)
+
+// RegisterHTTPMapping associates an HTTP status code with a numeric error
+// code, independent of codespace, so a handler can translate CodeOf's
+// result into a response status without a per-endpoint switch statement.
+func RegisterHTTPMapping(code uint32, status int) {
+	httpMappingMu.Lock()
+	defer httpMappingMu.Unlock()
+	httpMapping[code] = status
+}
+
+// HTTPStatus returns the HTTP status registered via RegisterHTTPMapping for
+// err's code. ok is false if err has no code, or if its code has no
+// mapping registered.
+func HTTPStatus(err error) (status int, ok bool) {
+	_, code, found := CodeOf(err)
+	if !found {
+		return 0, false
+	}
+	status, ok = httpMapping[code]
+	return status, ok
+}
@@ -0,0 +1,106 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	t.Run("registered sentinel carries its code", func(t *testing.T) {
+		err := Register("mymodule", 1, "not found")
+
+		codespace, code, ok := CodeOf(err)
+		assert.True(t, ok)
+		assert.Equal(t, "mymodule", codespace)
+		assert.Equal(t, uint32(1), code)
+	})
+
+	t.Run("duplicate registration panics", func(t *testing.T) {
+		Register("dupspace", 1, "first")
+		assert.Panics(t, func() {
+			Register("dupspace", 1, "second")
+		})
+	})
+
+	t.Run("registered sentinel is cheap to compare with Is", func(t *testing.T) {
+		sentinel := Register("cmpspace", 1, "boom")
+		wrapped := Wrap(sentinel, "while doing the thing")
+
+		assert.True(t, Is(wrapped, sentinel))
+	})
+}
+
+func TestWithCode(t *testing.T) {
+	t.Run("nil error stays nil", func(t *testing.T) {
+		assert.Nil(t, WithCode(nil, Code(42)))
+	})
+}
+
+func TestCodeOf(t *testing.T) {
+	t.Run("finds the code on the error itself", func(t *testing.T) {
+		err := WithCode(New("boom"), Code(42))
+
+		_, code, ok := CodeOf(err)
+		assert.True(t, ok)
+		assert.Equal(t, uint32(42), code)
+	})
+
+	t.Run("finds the code on a wrapped ancestor", func(t *testing.T) {
+		sentinel := Register("findspace", 7, "not allowed")
+		wrapped := Wrap(sentinel, "request failed")
+
+		codespace, code, ok := CodeOf(wrapped)
+		assert.True(t, ok)
+		assert.Equal(t, "findspace", codespace)
+		assert.Equal(t, uint32(7), code)
+	})
+
+	t.Run("no code anywhere in the tree", func(t *testing.T) {
+		_, _, ok := CodeOf(New("plain"))
+		assert.False(t, ok)
+	})
+}
+
+func TestInfo(t *testing.T) {
+	t.Run("with a code", func(t *testing.T) {
+		sentinel := Register("infospace", 3, "denied")
+
+		codespace, code, log := Info(sentinel)
+		assert.Equal(t, "infospace", codespace)
+		assert.Equal(t, uint32(3), code)
+		assert.Contains(t, log, "infospace")
+		assert.Contains(t, log, "denied")
+	})
+
+	t.Run("without a code", func(t *testing.T) {
+		codespace, code, log := Info(New("plain"))
+		assert.Equal(t, "", codespace)
+		assert.Equal(t, uint32(0), code)
+		assert.Equal(t, "plain", log)
+	})
+}
+
+func TestHTTPStatus(t *testing.T) {
+	RegisterHTTPMapping(404, 404)
+
+	t.Run("mapped code", func(t *testing.T) {
+		err := WithCode(New("missing"), Code(404))
+
+		status, ok := HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, 404, status)
+	})
+
+	t.Run("unmapped code", func(t *testing.T) {
+		err := WithCode(New("mystery"), Code(999))
+
+		_, ok := HTTPStatus(err)
+		assert.False(t, ok)
+	})
+
+	t.Run("no code at all", func(t *testing.T) {
+		_, ok := HTTPStatus(New("plain"))
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,99 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Errorf mirrors the Go 1.20 fmt.Errorf upgrade: every %w verb in format
+// wraps its corresponding argument as a child, the same way multiple %w
+// verbs make fmt.Errorf's result implement Unwrap() []error, so Is and As
+// find any of them. The rendered message becomes e's own top-level
+// context. Unlike Wrapf, which can only ever attach one cause, Errorf
+// lets callers attach as many as the format string names, without
+// falling back to a Join + Wrap combination.
+//
+// If a %w verb's argument doesn't implement error, fmt.Errorf would
+// silently drop it from the wrapped tree (go vet catches the mistake at
+// build time, but only for literal fmt.Errorf calls). Errorf instead
+// returns an *Error describing the misuse, since it has no caller to
+// report a separate error to. Use MustErrorf in a package-level sentinel
+// declaration, where even that isn't available.
+func Errorf(format string, args ...any) *Error {
+	wrapped, err := buildErrorf(format, args...)
+	if err != nil {
+		return &Error{err: err, trace: callers(1)}
+	}
+	return wrapped
+}
+
+// MustErrorf is like Errorf, but panics if a %w verb's argument doesn't
+// implement error. It exists for package-level sentinel declarations,
+// where a misuse needs to fail loudly at init time rather than produce a
+// working-looking *Error that silently omits the bad operand.
+func MustErrorf(format string, args ...any) *Error {
+	wrapped, err := buildErrorf(format, args...)
+	if err != nil {
+		panic(err)
+	}
+	return wrapped
+}
+
+func buildErrorf(format string, args ...any) (*Error, error) {
+	for _, i := range wVerbArgIndices(format) {
+		if i >= len(args) {
+			continue // let fmt.Errorf's own MISSING indicator surface this
+		}
+		if _, ok := args[i].(error); !ok {
+			return nil, fmt.Errorf("errors: Errorf argument %d for %%w does not implement error (%T)", i+1, args[i])
+		}
+	}
+
+	return &Error{
+		err:   fmt.Errorf(format, args...),
+		trace: callers(2),
+	}, nil
+}
+
+// wVerbArgIndices scans format for %w verbs - the multi-error wrap verb
+// fmt.Errorf added in Go 1.20 - and returns the zero-based index, into
+// args, of the value each one consumes. It tracks flags and literal
+// width/precision digits interleaved with '*'-supplied width/precision
+// arguments (each of which consumes an argument of its own, e.g.
+// "%-*.*f") well enough for the sequential verbs an error message format
+// string actually uses; like fmt.Errorf's own vet check, it doesn't
+// resolve explicit argument indices (%[2]w).
+func wVerbArgIndices(format string) []int {
+	var indices []int
+	argIndex := 0
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+		if i >= len(format) {
+			break
+		}
+		if format[i] == '%' {
+			continue // literal percent, consumes no argument
+		}
+
+		for i < len(format) && (strings.ContainsRune("+-# 0123456789.", rune(format[i])) || format[i] == '*') {
+			if format[i] == '*' {
+				argIndex++
+			}
+			i++
+		}
+		if i >= len(format) {
+			break
+		}
+
+		if format[i] == 'w' {
+			indices = append(indices, argIndex)
+		}
+		argIndex++
+	}
+
+	return indices
+}
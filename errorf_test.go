@@ -0,0 +1,75 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorf(t *testing.T) {
+	t.Run("renders the message with %w substituted like %v", func(t *testing.T) {
+		err := Errorf("while doing X: %w", errors.New("boom"))
+		assert.Equal(t, "while doing X: boom", err.Error())
+	})
+
+	t.Run("a single %w is found by Is and As", func(t *testing.T) {
+		root := errors.New("root")
+		err := Errorf("context: %w", root)
+
+		assert.True(t, Is(err, root))
+		assert.Equal(t, []error{root}, err.Unwrap())
+	})
+
+	t.Run("multiple %w verbs are all reachable via Is", func(t *testing.T) {
+		err1 := errors.New("err1")
+		err2 := errors.New("err2")
+		err := Errorf("%w and %w", err1, err2)
+
+		assert.True(t, Is(err, err1))
+		assert.True(t, Is(err, err2))
+		assert.ElementsMatch(t, []error{err1, err2}, err.Unwrap())
+	})
+
+	t.Run("no %w verbs behaves like Newf", func(t *testing.T) {
+		err := Errorf("plain message %d", 42)
+		assert.Equal(t, "plain message 42", err.Error())
+		assert.Empty(t, err.Unwrap())
+	})
+
+	t.Run("a dual-* width/precision verb before %w doesn't throw off argument counting", func(t *testing.T) {
+		root := errors.New("root")
+		err := Errorf("%-*.*f %w", 8, 2, 3.14159, root)
+
+		assert.Equal(t, "3.14     root", err.Error())
+		assert.True(t, Is(err, root))
+	})
+
+	t.Run("a non-error %w argument reports the mistake instead of silently dropping it", func(t *testing.T) {
+		// Called through a variable so `go vet`'s printf check - which would
+		// otherwise flag this literal misuse at build time, the same way it
+		// does for fmt.Errorf - doesn't recognize the call and let us
+		// exercise Errorf's own runtime check instead.
+		errorf := Errorf
+		err := errorf("context: %w", "not an error")
+		assert.Contains(t, err.Error(), "Errorf argument 1 for %w does not implement error")
+		assert.Contains(t, err.Error(), "string")
+	})
+}
+
+func TestMustErrorf(t *testing.T) {
+	t.Run("returns normally when every %w argument implements error", func(t *testing.T) {
+		root := errors.New("root")
+		assert.NotPanics(t, func() {
+			err := MustErrorf("context: %w", root)
+			assert.True(t, Is(err, root))
+		})
+	})
+
+	t.Run("panics when a %w argument doesn't implement error", func(t *testing.T) {
+		mustErrorf := MustErrorf
+		assert.PanicsWithError(t, "errors: Errorf argument 1 for %w does not implement error (string)", func() {
+			mustErrorf("context: %w", "oops")
+		})
+	})
+}
@@ -1,17 +1,39 @@
 // Package errors provides a wrapper around Go's standard error handling with additional features:
 //   - JSON marshalling support (errors serialize as string arrays)
-//   - Error formatting with template variables via Format()
+//   - Error formatting with template variables via Template()
+//   - Stack-frame capture, resolved on demand via fmt.Sprintf("%+v", err)
 //   - IsNil() function to detect typed nil errors
 //   - Error wrapping using errors.Join internally
+//   - Errorf(), for attaching more than one cause via repeated %w verbs
 //
 // The main Error type wraps standard Go errors and provides compatibility with
 // errors.Is, errors.As, and errors.Unwrap while adding JSON serialization.
+//
+// BREAKING CHANGE: *Error's template-formatting method, (e *Error)
+// Format(args ...any) error, was renamed to Template to make room for the
+// fmt.Formatter implementation added below (Format(f fmt.State, verb
+// rune) - a different signature under the same name, which Go doesn't
+// allow on one type). Any caller doing err.Format(arg1, arg2) needs to
+// become err.Template(arg1, arg2); this module has no CHANGELOG or v2
+// module path yet, so this note is the only warning existing importers
+// get - treat the next tagged release as a major version bump.
+//
+// BREAKING CHANGE: Error() on a Join result with more than one child now
+// renders through a configurable join formatter that defaults to the
+// go-multierror bullet style ("N errors occurred:\n\t* msg1\n\t* msg2"),
+// not the plain newline-joined message this package produced before. Since
+// MarshalJSON splits Error() on "\n", this also changes the JSON array a
+// multi-child Join result serializes to. Callers relying on either the old
+// Error() string or its JSON shape should call
+// SetJoinFormatter(LegacyJoinFormat) to restore it.
 package errors
 
 import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"reflect"
 	"strings"
 	"unsafe"
 )
@@ -19,18 +41,23 @@ import (
 // Error wraps a standard Go error with additional functionality.
 // The wrapped error is unexported to maintain encapsulation.
 type Error struct {
-	err error
+	err    error
+	trace  stack
+	code   *codeInfo
+	isJoin bool
 }
 
 func New(msg string) *Error {
 	return &Error{
-		err: errors.New(msg),
+		err:   errors.New(msg),
+		trace: callers(1),
 	}
 }
 
 func Newf(msg string, args ...any) *Error {
 	return &Error{
-		err: fmt.Errorf(msg, args...),
+		err:   fmt.Errorf(msg, args...),
+		trace: callers(1),
 	}
 }
 
@@ -40,12 +67,14 @@ func Newf(msg string, args ...any) *Error {
 func Wrap(err error, msg string) *Error {
 	if err == nil {
 		return &Error{
-			err: fmt.Errorf(msg),
+			err:   fmt.Errorf(msg),
+			trace: callers(1),
 		}
 	}
 
 	return &Error{
-		err: errors.Join(fmt.Errorf(msg), err),
+		err:   errors.Join(fmt.Errorf(msg), err),
+		trace: callers(1),
 	}
 }
 
@@ -55,38 +84,82 @@ func Wrap(err error, msg string) *Error {
 func Wrapf(err error, msg string, args ...any) *Error {
 	if err == nil {
 		return &Error{
-			err: fmt.Errorf(msg, args...),
+			err:   fmt.Errorf(msg, args...),
+			trace: callers(1),
 		}
 	}
 
 	return &Error{
-		err: errors.Join(fmt.Errorf(msg, args...), err),
+		err:   errors.Join(fmt.Errorf(msg, args...), err),
+		trace: callers(1),
 	}
 }
 
-// Unwrap returns the result of calling the Unwrap method on err, if err's type contains
-// an Unwrap method returning error. Otherwise, Unwrap returns nil.
-// This is a convenience wrapper around errors.Unwrap.
+// Unwrap returns err's single child if it has exactly one, and nil
+// otherwise - including when err has zero children (a leaf) or more than
+// one (an *Error built from Wrap/Wrapf/Join, or any errors.Join result).
+// This mirrors how the Go 1.20 errors package splits wrapping into two
+// signatures, Unwrap() error for a single child and Unwrap() []error for
+// several: a type implementing the latter was never meant to collapse back
+// down to one value. If err has neither Unwrap signature, Unwrap falls
+// back to interface{ Cause() error }, so it also steps through a legacy
+// pkg/errors-style chain.
 func Unwrap(err error) error {
-	return errors.Unwrap(err)
+	if u, ok := err.(interface{ Unwrap() []error }); ok {
+		if children := u.Unwrap(); len(children) == 1 {
+			return children[0]
+		}
+		return nil
+	}
+	if u := errors.Unwrap(err); u != nil {
+		return u
+	}
+	if c, ok := err.(interface{ Cause() error }); ok {
+		return c.Cause()
+	}
+	return nil
 }
 
 func Join(errs ...error) error {
 	return &Error{
-		err: errors.Join(errs...),
+		err:    errors.Join(errs...),
+		isJoin: true,
 	}
 }
 
 // Is reports whether any error in err's chain matches target.
-// It unwraps Formatted errors to their parent before checking.
+// It unwraps Formatted errors to their parent before checking, and, like
+// walkErrors, follows Cause() error as well as Unwrap, so it also finds
+// target across a chain mixing this module's errors with a legacy
+// pkg/errors-style causer.
 // This function is compatible with errors.Is and can be used interchangeably.
 func Is(err, target error) bool {
+	// Match stdlib: a nil target only matches a nil err, checked up front
+	// since walkErrors never invokes its callback for a nil root.
+	if target == nil {
+		return err == target
+	}
+
 	// If the source error is formatted, unwrap to the parent
 	if e := Unformatted(err); e != nil {
 		err = e
 	}
 
-	return errors.Is(err, target)
+	targetComparable := reflect.TypeOf(target) == nil || reflect.TypeOf(target).Comparable()
+
+	found := false
+	walkErrors(err, func(err error) bool {
+		if targetComparable && err == target {
+			found = true
+			return true
+		}
+		if x, ok := err.(interface{ Is(error) bool }); ok && x.Is(target) {
+			found = true
+			return true
+		}
+		return false
+	})
+	return found
 }
 
 // Unformatted returns the unformatted parent error if err is a FormattedError.
@@ -98,19 +171,109 @@ func Unformatted(err error) *Error {
 	return nil
 }
 
-// As finds the first error in err's chain that matches target.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// As finds the first error in err's chain that matches target, the same
+// way errors.As does, but additionally following Cause() error when
+// neither Unwrap signature is present, so it also reaches a target buried
+// in a legacy pkg/errors-style chain.
 // This function is compatible with errors.As and can be used interchangeably.
 func As(err error, target any) bool {
-	return errors.As(err, target)
+	if err == nil {
+		return false
+	}
+	if target == nil {
+		panic("errors: target cannot be nil")
+	}
+
+	val := reflect.ValueOf(target)
+	typ := val.Type()
+	if typ.Kind() != reflect.Ptr || val.IsNil() {
+		panic("errors: target must be a non-nil pointer")
+	}
+	targetType := typ.Elem()
+	if targetType.Kind() != reflect.Interface && !targetType.Implements(errorType) {
+		panic("errors: *target must be interface or implement error")
+	}
+
+	for {
+		if reflect.TypeOf(err).AssignableTo(targetType) {
+			val.Elem().Set(reflect.ValueOf(err))
+			return true
+		}
+		if x, ok := err.(interface{ As(any) bool }); ok && x.As(target) {
+			return true
+		}
+
+		switch x := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, child := range x.Unwrap() {
+				if As(child, target) {
+					return true
+				}
+			}
+			return false
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		case interface{ Cause() error }:
+			err = x.Cause()
+		default:
+			return false
+		}
+		if err == nil {
+			return false
+		}
+	}
 }
 
+// Error returns e's message. For a Join result with more than one child,
+// it's rendered through the configurable join formatter (see
+// SetJoinFormatter); everything else just renders the wrapped error as-is.
 func (e Error) Error() string {
+	if e.isJoin {
+		if children := e.Unwrap(); len(children) > 1 {
+			return currentJoinFormatter()(children)
+		}
+	}
 	return e.err.Error()
 }
 
-// Unwrap returns the wrapped error, allowing errors.Is and errors.As to work correctly.
-func (e *Error) Unwrap() error {
-	return e.err
+// Unwrap returns the flat slice of non-nil children e was built from: for
+// Wrap and Wrapf, that's [contextErr, wrappedErr]; for Join, the errs it was
+// given, minus any nils; for New, and Newf without a %w verb, nil, so
+// stdlib treats e as a leaf. Implementing the Go 1.20 multi-error Unwrap signature lets
+// errors.Is and errors.As perform a proper pre-order depth-first walk of
+// e's tree instead of treating the internal errors.Join result as a single
+// opaque child.
+func (e *Error) Unwrap() []error {
+	if u, ok := e.err.(interface{ Unwrap() []error }); ok {
+		return u.Unwrap()
+	}
+	// A single %w (via Errorf or Newf) produces a value with only the singular
+	// Unwrap() error signature; normalize it to a one-element slice so it
+	// still participates in the tree walk like any other child.
+	if u, ok := e.err.(interface{ Unwrap() error }); ok {
+		if next := u.Unwrap(); next != nil {
+			return []error{next}
+		}
+	}
+	return nil
+}
+
+// Cause returns e's last child, satisfying the interface{ Cause() error }
+// convention pkg/errors and the pre-1.13 hashicorp ecosystem use. This lets
+// *Error interop with legacy code that still calls errors.Cause instead of
+// walking the stdlib Unwrap-based chain. The last child, rather than the
+// first, is what a legacy Cause()-walker needs: for a Wrap/Wrapf result,
+// e's children are [contextErr, wrappedErr], and it's wrappedErr - the
+// actual wrapped error, not e's own decorative message - that such a
+// walker expects to reach.
+func (e *Error) Cause() error {
+	children := e.Unwrap()
+	if len(children) == 0 {
+		return nil
+	}
+	return children[len(children)-1]
 }
 
 func (e Error) MarshalJSON() ([]byte, error) {
@@ -118,6 +281,45 @@ func (e Error) MarshalJSON() ([]byte, error) {
 	return json.Marshal(strs)
 }
 
+// errorFrames is the JSON shape produced by MarshalJSONWithFrames.
+type errorFrames struct {
+	Messages []string `json:"messages"`
+	Frames   []Frame  `json:"frames"`
+}
+
+// MarshalJSONWithFrames marshals e as an object carrying both the messages
+// MarshalJSON already produces and the resolved stack frames for every
+// *Error in e's tree. Callers that want the plain string-array format must
+// keep using MarshalJSON (or json.Marshal, which uses it by default); this
+// method is opt-in since adding frames to every payload would be a breaking
+// change for existing consumers.
+func (e Error) MarshalJSONWithFrames() ([]byte, error) {
+	return json.Marshal(errorFrames{
+		Messages: strings.Split(e.Error(), "\n"),
+		Frames:   traceFrames(&e),
+	})
+}
+
+// Format implements fmt.Formatter. %s and %v print the same thing as
+// Error(); %+v additionally appends a "\nfunc\n\tfile:line" stack frame for
+// every *Error node in e's tree (see Unwrap), resolved lazily at format
+// time rather than at construction.
+func (e *Error) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			_, _ = io.WriteString(f, e.Error())
+			writeFrames(f, traceFrames(e))
+			return
+		}
+		fallthrough
+	case 's':
+		_, _ = io.WriteString(f, e.Error())
+	case 'q':
+		_, _ = fmt.Fprintf(f, "%q", e.Error())
+	}
+}
+
 type iface struct {
 	tab  unsafe.Pointer
 	data unsafe.Pointer
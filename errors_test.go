@@ -2,6 +2,7 @@ package errors
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,10 @@ func TestIs(t *testing.T) {
 	err3 := Wrap(err2, "wrap")
 	assert.True(t, Is(err3, err2))
 	assert.True(t, Is(err3, err))
+
+	// Matches stdlib errors.Is: a nil target only matches a nil err.
+	assert.True(t, Is(nil, nil))
+	assert.False(t, Is(err, nil))
 }
 
 type CustomError struct {
@@ -89,23 +94,35 @@ func TestMarshalJSON(t *testing.T) {
 }
 
 func TestUnwrap(t *testing.T) {
-	t.Run("unwrap Error returns wrapped error", func(t *testing.T) {
+	t.Run("method returns the two children of a Wrap", func(t *testing.T) {
 		inner := errors.New("inner")
 		outer := Wrap(inner, "outer")
 
-		unwrapped := outer.Unwrap()
-		assert.NotNil(t, unwrapped)
-		// Unwrap should return the joined error
-		assert.True(t, errors.Is(unwrapped, inner))
+		children := outer.Unwrap()
+		assert.Len(t, children, 2)
+		assert.True(t, errors.Is(children[1], inner))
+	})
+
+	t.Run("method returns nil for a leaf Error", func(t *testing.T) {
+		err := New("leaf")
+		assert.Nil(t, err.Unwrap())
 	})
 
-	t.Run("unwrap function with Error", func(t *testing.T) {
+	t.Run("function returns nil when Error has more than one child", func(t *testing.T) {
 		inner := errors.New("inner")
 		outer := Wrap(inner, "outer")
 
+		// Wrap always has two children (context + wrapped), so the
+		// single-value Unwrap function can't collapse it to one value.
+		assert.Nil(t, Unwrap(outer))
+	})
+
+	t.Run("function returns the single child when there is exactly one", func(t *testing.T) {
+		inner := errors.New("inner")
+		outer := fmt.Errorf("outer: %w", inner)
+
 		unwrapped := Unwrap(outer)
-		assert.NotNil(t, unwrapped)
-		assert.True(t, errors.Is(unwrapped, inner))
+		assert.Equal(t, inner, unwrapped)
 	})
 
 	t.Run("unwrap nil returns nil", func(t *testing.T) {
@@ -114,6 +131,37 @@ func TestUnwrap(t *testing.T) {
 	})
 }
 
+func TestUnwrapTree(t *testing.T) {
+	t.Run("errors.Is/As reach every node of a joined tree", func(t *testing.T) {
+		custom := &CustomError{msg: "custom"}
+		leaf1 := errors.New("leaf1")
+		leaf2 := errors.New("leaf2")
+
+		branch1 := Wrap(leaf1, "branch1")
+		branch2 := Wrap(custom, "branch2")
+		root := Join(branch1, branch2, leaf2)
+
+		assert.True(t, errors.Is(root, leaf1))
+		assert.True(t, errors.Is(root, leaf2))
+		assert.True(t, errors.Is(root, branch1))
+		assert.True(t, errors.Is(root, branch2))
+
+		var target *CustomError
+		assert.True(t, errors.As(root, &target))
+		assert.Equal(t, custom, target)
+	})
+
+	t.Run("Unformatted still resolves through the new traversal", func(t *testing.T) {
+		inner := errors.New("inner")
+		outer := Wrap(inner, "outer")
+		formatted := outer.Template()
+
+		assert.True(t, Is(formatted, outer))
+		assert.True(t, Is(formatted, inner))
+		assert.True(t, errors.Is(Unformatted(formatted), inner))
+	})
+}
+
 func TestStdlibCompatibility(t *testing.T) {
 	t.Run("stdlib errors.Is works with Error", func(t *testing.T) {
 		base := errors.New("base error")
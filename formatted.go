@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
 // FormattedError represents a formatted instance of an Error.
 type FormattedError struct {
@@ -18,26 +21,50 @@ func (f *FormattedError) Unwrap() error {
 	return f.parent
 }
 
-// Format applies fmt.Sprintf to the current error's Error() string and returns a formatted instance.
+// Template applies fmt.Sprintf to the current error's Error() string and returns a formatted instance.
 //
 // The error message should contain valid fmt.Sprintf format verbs (e.g., %s, %d, %v).
 // Example:
 //
 //	err := New("failed to process item %d: %s")
-//	formatted := err.Format(42, "invalid input")
+//	formatted := err.Template(42, "invalid input")
 //	// formatted.Error() returns: "failed to process item 42: invalid input"
 //
 // IMPORTANT: While fmt.Sprintf doesn't panic, it will produce error indicators in the output for:
-//   - Missing arguments: "error: %s %d" with Format("test") produces "error: test %!d(MISSING)"
-//   - Extra arguments: "error: %s" with Format("test", "extra") produces "error: test%!(EXTRA ...)"
-//   - Invalid/unknown verbs: "value: %z" with Format(42) produces "value: %!z(int=42)"
-//   - Bare % characters: "100% complete" with Format() produces "100%!(NOVERB) complete"
+//   - Missing arguments: "error: %s %d" with Template("test") produces "error: test %!d(MISSING)"
+//   - Extra arguments: "error: %s" with Template("test", "extra") produces "error: test%!(EXTRA ...)"
+//   - Invalid/unknown verbs: "value: %z" with Template(42) produces "value: %!z(int=42)"
+//   - Bare % characters: "100% complete" with Template() produces "100%!c(MISSING)omplete" ("% c" parses as a %c verb with the space flag)
 //
-// Best practice: Only call Format() on errors that were created with format templates.
-// For non-template error messages, use the error directly without calling Format().
-func (e *Error) Format(args ...any) error {
+// Best practice: Only call Template() on errors that were created with format templates.
+// For non-template error messages, use the error directly without calling Template().
+//
+// This method used to be named Format, but that collides with the
+// fmt.Formatter interface now implemented by *Error and *FormattedError
+// (see Format below), so it was renamed to avoid the two meaning
+// different things depending on how they're invoked.
+func (e *Error) Template(args ...any) error {
 	return &FormattedError{
 		parent: e,
 		msg:    fmt.Sprintf(e.err.Error(), args...),
 	}
 }
+
+// Format implements fmt.Formatter. %s and %v print the same thing as
+// Error(); %+v additionally appends a stack frame for every *Error in the
+// parent's tree, the same as calling fmt.Sprintf("%+v", ...) on the parent.
+func (f *FormattedError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			_, _ = io.WriteString(s, f.Error())
+			writeFrames(s, traceFrames(f.parent))
+			return
+		}
+		fallthrough
+	case 's':
+		_, _ = io.WriteString(s, f.Error())
+	case 'q':
+		_, _ = fmt.Fprintf(s, "%q", f.Error())
+	}
+}
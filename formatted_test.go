@@ -6,43 +6,43 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestError_Format(t *testing.T) {
+func TestError_Template(t *testing.T) {
 	err := New("test %v")
 	assert.NotNil(t, err)
 
-	formatted := err.Format(2)
+	formatted := err.Template(2)
 	assert.Equal(t, "test 2", formatted.Error())
 }
 
-func TestError_FormatIs(t *testing.T) {
+func TestError_TemplateIs(t *testing.T) {
 	UnformattedError := New("test %v")
 	assert.NotNil(t, UnformattedError)
 
-	formatted := UnformattedError.Format(2)
+	formatted := UnformattedError.Template(2)
 	assert.Equal(t, "test 2", formatted.Error())
 
 	// Must use your package's Is function (which correctly unwraps Formatted)
 	assert.True(t, Is(formatted, UnformattedError), "errors.Is should match the original unformatted error")
 }
 
-func TestError_FormatBehavior(t *testing.T) {
+func TestError_TemplateBehavior(t *testing.T) {
 	t.Run("format with valid template", func(t *testing.T) {
 		err := New("error: %s (code: %d)")
-		formatted := err.Format("test", 42)
+		formatted := err.Template("test", 42)
 		assert.Equal(t, "error: test (code: 42)", formatted.Error())
 	})
 
 	t.Run("format with percent sign", func(t *testing.T) {
 		// Note: fmt.Sprintf handles bare % by outputting %!(NOVERB)
 		err := New("100% complete")
-		formatted := err.Format()
+		formatted := err.Template()
 		// fmt.Sprintf treats bare % as an error but doesn't panic
 		assert.Contains(t, formatted.Error(), "%")
 	})
 
 	t.Run("format with missing arguments", func(t *testing.T) {
 		err := New("error: %s %d")
-		formatted := err.Format("test")
+		formatted := err.Template("test")
 		// fmt.Sprintf handles missing args by outputting %!d(MISSING)
 		assert.Contains(t, formatted.Error(), "test")
 		assert.Contains(t, formatted.Error(), "MISSING")
@@ -50,16 +50,16 @@ func TestError_FormatBehavior(t *testing.T) {
 
 	t.Run("format with extra arguments", func(t *testing.T) {
 		err := New("error: %s")
-		formatted := err.Format("test", "extra", 42)
+		formatted := err.Template("test", "extra", 42)
 		// fmt.Sprintf outputs EXTRA indicators for unused arguments
 		assert.Contains(t, formatted.Error(), "test")
 		assert.Contains(t, formatted.Error(), "EXTRA")
 	})
 }
 
-func TestError_FormatUnwrap(t *testing.T) {
+func TestError_TemplateUnwrap(t *testing.T) {
 	original := New("test %v")
-	formatted := original.Format(42)
+	formatted := original.Template(42)
 
 	// FormattedError should unwrap to the original Error
 	unwrapped := Unwrap(formatted)
@@ -69,7 +69,7 @@ func TestError_FormatUnwrap(t *testing.T) {
 func TestUnformatted(t *testing.T) {
 	t.Run("formatted error returns parent", func(t *testing.T) {
 		original := New("test %v")
-		formatted := original.Format(42)
+		formatted := original.Template(42)
 
 		parent := Unformatted(formatted)
 		assert.NotNil(t, parent)
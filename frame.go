@@ -0,0 +1,55 @@
+package errors
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Frame describes a single resolved stack frame captured at the point an
+// *Error was constructed.
+type Frame struct {
+	Func string `json:"func"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// frames lazily resolves s's raw program counters into Frame values.
+func (s stack) frames() []Frame {
+	if len(s) == 0 {
+		return nil
+	}
+
+	frames := make([]Frame, 0, len(s))
+	ci := runtime.CallersFrames(s)
+	for {
+		f, more := ci.Next()
+		frames = append(frames, Frame{Func: f.Function, File: f.File, Line: f.Line})
+		if !more {
+			break
+		}
+	}
+	return frames
+}
+
+// traceFrames walks err's tree, in traversal order, and returns the
+// resolved stack frames recorded by every *Error node it finds.
+func traceFrames(err error) []Frame {
+	var frames []Frame
+
+	walkErrors(err, func(err error) bool {
+		if e, ok := err.(*Error); ok {
+			frames = append(frames, e.trace.frames()...)
+		}
+		return false
+	})
+
+	return frames
+}
+
+// writeFrames writes each frame to w as "\nfunc\n\tfile:line", matching the
+// layout fmt.Sprintf("%+v", err) produces for a chain of *Error values.
+func writeFrames(w fmt.State, frames []Frame) {
+	for _, f := range frames {
+		fmt.Fprintf(w, "\n%s\n\t%s:%d", f.Func, f.File, f.Line)
+	}
+}
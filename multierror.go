@@ -0,0 +1,90 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Errors returns e's flat list of children: empty for a leaf error, the
+// non-nil errs given to Join, or [contextErr, wrappedErr] for Wrap/Wrapf. It's
+// Unwrap's result with nil normalized to an empty slice, for callers that
+// would rather not special-case nil.
+func (e *Error) Errors() []error {
+	if children := e.Unwrap(); children != nil {
+		return children
+	}
+	return []error{}
+}
+
+// Errors returns err's flat list of direct children, unwrapping a
+// FormattedError to its parent first exactly like Is does. It understands
+// any error implementing the Go 1.20 Unwrap() []error signature, not just
+// *Error, so it also works directly on a plain errors.Join result.
+func Errors(err error) []error {
+	if e := Unformatted(err); e != nil {
+		err = e
+	}
+
+	if u, ok := err.(interface{ Unwrap() []error }); ok {
+		if children := u.Unwrap(); children != nil {
+			return children
+		}
+	}
+	return []error{}
+}
+
+// joinFormatter renders the Error() string for a Join result with more
+// than one child. It defaults to bulletJoinFormat; override it with
+// SetJoinFormatter. joinFormatterMu guards it, the same way stack.go
+// guards traceDepth, since Error() reads it on every call while
+// SetJoinFormatter may be called concurrently from another goroutine.
+var (
+	joinFormatterMu sync.Mutex
+	joinFormatter   = bulletJoinFormat
+)
+
+// SetJoinFormatter overrides how a Join result with more than one child
+// renders its Error() string. Pass LegacyJoinFormat to restore the plain
+// newline-joined format this package used before the bullet style became
+// the default, e.g. if you're relying on MarshalJSON splitting that output
+// on "\n" into one element per child.
+func SetJoinFormatter(f func([]error) string) {
+	joinFormatterMu.Lock()
+	joinFormatter = f
+	joinFormatterMu.Unlock()
+}
+
+// currentJoinFormatter returns the active join formatter under the lock
+// that protects it against concurrent SetJoinFormatter calls.
+func currentJoinFormatter() func([]error) string {
+	joinFormatterMu.Lock()
+	f := joinFormatter
+	joinFormatterMu.Unlock()
+	return f
+}
+
+// bulletJoinFormat is the default join formatter, reproducing the
+// go-multierror bullet style: "N errors occurred:\n\t* msg1\n\t* msg2". It
+// deliberately has no trailing newline, unlike go-multierror's own
+// default, so MarshalJSON splitting Error() on "\n" doesn't produce a
+// spurious empty trailing element.
+func bulletJoinFormat(errs []error) string {
+	lines := make([]string, len(errs)+1)
+	lines[0] = fmt.Sprintf("%d errors occurred:", len(errs))
+	for i, err := range errs {
+		lines[i+1] = "\t* " + err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// LegacyJoinFormat reproduces this package's original Join formatting: each
+// child's message newline-joined, the same shape errors.Join itself
+// produces.
+func LegacyJoinFormat(errs []error) string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
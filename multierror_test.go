@@ -0,0 +1,78 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_Errors(t *testing.T) {
+	t.Run("leaf error has no children", func(t *testing.T) {
+		err := New("leaf")
+		assert.Empty(t, err.Errors())
+	})
+
+	t.Run("Wrap returns [contextErr, wrappedErr]", func(t *testing.T) {
+		inner := errors.New("inner")
+		outer := Wrap(inner, "outer")
+
+		children := outer.Errors()
+		assert.Len(t, children, 2)
+		assert.Equal(t, "outer", children[0].Error())
+		assert.Equal(t, inner, children[1])
+	})
+
+	t.Run("Join returns the original errs", func(t *testing.T) {
+		err1 := errors.New("err1")
+		err2 := errors.New("err2")
+		joined := Join(err1, err2).(*Error)
+
+		assert.Equal(t, []error{err1, err2}, joined.Errors())
+	})
+}
+
+func TestErrors(t *testing.T) {
+	t.Run("resolves through a FormattedError to its parent", func(t *testing.T) {
+		inner := errors.New("inner")
+		outer := Wrap(inner, "outer")
+		formatted := outer.Template()
+
+		children := Errors(formatted)
+		assert.Len(t, children, 2)
+		assert.Equal(t, inner, children[1])
+	})
+
+	t.Run("empty slice for a leaf error", func(t *testing.T) {
+		assert.Empty(t, Errors(New("leaf")))
+	})
+}
+
+func TestJoinFormatter(t *testing.T) {
+	defer SetJoinFormatter(bulletJoinFormat)
+
+	t.Run("default bullet style for more than one child", func(t *testing.T) {
+		joined := Join(errors.New("err1"), errors.New("err2"))
+
+		msg := joined.Error()
+		assert.Equal(t, "2 errors occurred:\n\t* err1\n\t* err2", msg)
+	})
+
+	t.Run("single-child Join isn't bulleted", func(t *testing.T) {
+		joined := Join(errors.New("err1"), nil, nil)
+		assert.Equal(t, "err1", joined.Error())
+	})
+
+	t.Run("SetJoinFormatter(LegacyJoinFormat) restores newline-joined output", func(t *testing.T) {
+		SetJoinFormatter(LegacyJoinFormat)
+		defer SetJoinFormatter(bulletJoinFormat)
+
+		joined := Join(errors.New("err1"), errors.New("err2"))
+		assert.Equal(t, "err1\nerr2", joined.Error())
+	})
+
+	t.Run("Wrap's Error() is unaffected by the join formatter", func(t *testing.T) {
+		outer := Wrap(errors.New("inner"), "outer")
+		assert.Equal(t, "outer\ninner", outer.Error())
+	})
+}
@@ -0,0 +1,56 @@
+//go:build !errors_notrace
+
+package errors
+
+import (
+	"runtime"
+	"sync"
+)
+
+// defaultTraceDepth is the number of stack frames captured by New, Newf,
+// Wrap, and Wrapf when no explicit depth has been configured.
+const defaultTraceDepth = 32
+
+// traceDepth is the number of program counters captured at construction
+// time. It can be tuned with SetTraceDepth, including down to 0 to disable
+// capture without recompiling under the errors_notrace build tag.
+// traceDepthMu guards it, the same way code.go guards the error-code
+// registry, since callers() reads it on every construction while
+// SetTraceDepth may be called concurrently from another goroutine.
+var (
+	traceDepthMu sync.Mutex
+	traceDepth   = defaultTraceDepth
+)
+
+// SetTraceDepth configures how many stack frames are captured when
+// constructing a new *Error via New, Newf, Wrap, or Wrapf. It only affects
+// errors created after the call. A depth of 0 (or less) disables capture.
+func SetTraceDepth(depth int) {
+	if depth < 0 {
+		depth = 0
+	}
+	traceDepthMu.Lock()
+	traceDepth = depth
+	traceDepthMu.Unlock()
+}
+
+// stack is a captured, unresolved set of program counters. Resolving them
+// into file/line/function information is deferred until the error is
+// actually formatted, since most errors are never printed with %+v.
+type stack []uintptr
+
+// callers captures the stack of the calling goroutine, skipping skip frames
+// above its own in addition to runtime.Callers' own frame.
+func callers(skip int) stack {
+	traceDepthMu.Lock()
+	depth := traceDepth
+	traceDepthMu.Unlock()
+
+	if depth <= 0 {
+		return nil
+	}
+
+	pcs := make([]uintptr, depth)
+	n := runtime.Callers(skip+2, pcs)
+	return stack(pcs[:n])
+}
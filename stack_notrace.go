@@ -0,0 +1,17 @@
+//go:build errors_notrace
+
+package errors
+
+// SetTraceDepth is a no-op under the errors_notrace build tag: stack-frame
+// capture is compiled out entirely, so there is no depth to configure.
+func SetTraceDepth(depth int) {}
+
+// stack is a captured, unresolved set of program counters. Under
+// errors_notrace it is always empty, since callers never records anything.
+type stack []uintptr
+
+// callers is a no-op under the errors_notrace build tag, giving error
+// construction zero tracing overhead.
+func callers(skip int) stack {
+	return nil
+}
@@ -0,0 +1,87 @@
+//go:build !errors_notrace
+
+package errors
+
+// These tests exercise actual stack-frame capture, which is compiled out
+// entirely under errors_notrace (see stack_notrace.go); they have no
+// meaningful notrace counterpart.
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_FormatVerbose(t *testing.T) {
+	t.Run("%s and %v print only the message", func(t *testing.T) {
+		err := New("boom")
+		assert.Equal(t, "boom", fmt.Sprintf("%s", err))
+		assert.Equal(t, "boom", fmt.Sprintf("%v", err))
+	})
+
+	t.Run("%+v appends a stack frame", func(t *testing.T) {
+		err := New("boom")
+		out := fmt.Sprintf("%+v", err)
+		assert.True(t, strings.HasPrefix(out, "boom\n"))
+		assert.Contains(t, out, "TestError_FormatVerbose")
+		assert.Contains(t, out, "stack_test.go:")
+	})
+
+	t.Run("%+v walks every wrapped *Error in the tree", func(t *testing.T) {
+		inner := New("inner")
+		outer := Wrap(inner, "outer")
+
+		out := fmt.Sprintf("%+v", outer)
+		assert.Contains(t, out, "outer")
+		assert.Contains(t, out, "inner")
+		// One frame for outer's Wrap call, one for inner's New call.
+		assert.Equal(t, 2, strings.Count(out, "TestError_FormatVerbose"))
+	})
+
+	t.Run("FormattedError forwards to its parent's trace", func(t *testing.T) {
+		err := New("boom %d")
+		formatted := err.Template(1)
+
+		out := fmt.Sprintf("%+v", formatted)
+		assert.True(t, strings.HasPrefix(out, "boom 1\n"))
+		assert.Contains(t, out, "TestError_FormatVerbose")
+	})
+}
+
+func TestError_MarshalJSONWithFrames(t *testing.T) {
+	err := New("boom")
+
+	data, marshalErr := err.MarshalJSONWithFrames()
+	assert.NoError(t, marshalErr)
+
+	var decoded errorFrames
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, []string{"boom"}, decoded.Messages)
+	assert.NotEmpty(t, decoded.Frames)
+	assert.Contains(t, decoded.Frames[0].Func, "TestError_MarshalJSONWithFrames")
+
+	// The default MarshalJSON is unaffected, staying the plain string array.
+	plain, plainErr := err.MarshalJSON()
+	assert.NoError(t, plainErr)
+	assert.Equal(t, `["boom"]`, string(plain))
+}
+
+func TestSetTraceDepth(t *testing.T) {
+	original := traceDepth
+	defer SetTraceDepth(original)
+
+	SetTraceDepth(0)
+	err := New("boom")
+	assert.Empty(t, traceFrames(err))
+
+	SetTraceDepth(32)
+	err = New("boom")
+	assert.NotEmpty(t, traceFrames(err))
+
+	// Negative depths are clamped to 0 rather than misbehaving.
+	SetTraceDepth(-5)
+	assert.Equal(t, 0, traceDepth)
+}
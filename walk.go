@@ -0,0 +1,33 @@
+package errors
+
+// walkErrors performs a pre-order depth-first walk of err's tree, calling
+// visit for every node, including err itself. It follows both the
+// single-error and multi-error Unwrap signatures, so it works against
+// errors.Join results, *Error, and any third-party tree. If neither Unwrap
+// signature is present, it falls back to interface{ Cause() error }, the
+// convention pkg/errors and the pre-1.13 hashicorp ecosystem used, so a
+// chain mixing those libraries with this one still walks all the way to
+// its root. If visit returns true the walk stops immediately, even if more
+// of the tree is left to see.
+func walkErrors(err error, visit func(error) bool) bool {
+	if err == nil {
+		return false
+	}
+	if visit(err) {
+		return true
+	}
+
+	switch u := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, child := range u.Unwrap() {
+			if walkErrors(child, visit) {
+				return true
+			}
+		}
+	case interface{ Unwrap() error }:
+		return walkErrors(u.Unwrap(), visit)
+	case interface{ Cause() error }:
+		return walkErrors(u.Cause(), visit)
+	}
+	return false
+}